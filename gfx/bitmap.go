@@ -0,0 +1,21 @@
+package gfx
+
+import (
+	"image"
+
+	"github.com/apparentlymart/go-ssd1306/ssd1306"
+)
+
+// Bitmap draws img into fb with its top-left corner at (x, y),
+// converting each pixel to a 1-bit value via img's own color model.
+// Pixels that convert to non-black are drawn on.
+func Bitmap(fb *ssd1306.Framebuffer, x, y int, img image.Image) {
+	bounds := img.Bounds()
+	for iy := bounds.Min.Y; iy < bounds.Max.Y; iy++ {
+		for ix := bounds.Min.X; ix < bounds.Max.X; ix++ {
+			r, g, b, _ := img.At(ix, iy).RGBA()
+			on := r != 0 || g != 0 || b != 0
+			fb.SetPixel(x+(ix-bounds.Min.X), y+(iy-bounds.Min.Y), on)
+		}
+	}
+}
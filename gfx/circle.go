@@ -0,0 +1,87 @@
+package gfx
+
+import "github.com/apparentlymart/go-ssd1306/ssd1306"
+
+// Circle draws the outline of a circle of radius r centered at
+// (cx, cy) using the midpoint circle algorithm.
+func Circle(fb *ssd1306.Framebuffer, cx, cy, r int, c Color) {
+	x, y, err := r, 0, 0
+
+	for y <= x {
+		plotOctants(fb, cx, cy, x, y, c)
+		err += 2*y + 1
+		y++
+		if 2*(err-x)+1 > 0 {
+			err += 1 - 2*x
+			x--
+		}
+	}
+}
+
+// plotOctants plots the eight octant-symmetric points for a given
+// (x, y) offset from center. Near y=0 and where x==y, several of
+// those eight points coincide; each distinct point is plotted only
+// once, since with Color XOR plotting the same pixel twice would
+// cancel it back out.
+func plotOctants(fb *ssd1306.Framebuffer, cx, cy, x, y int, c Color) {
+	pts := [8][2]int{
+		{cx + x, cy + y},
+		{cx + y, cy + x},
+		{cx - y, cy + x},
+		{cx - x, cy + y},
+		{cx - x, cy - y},
+		{cx - y, cy - x},
+		{cx + y, cy - x},
+		{cx + x, cy - y},
+	}
+	plotUnique(fb, pts[:], c)
+}
+
+func plotUnique(fb *ssd1306.Framebuffer, pts [][2]int, c Color) {
+	for i, p := range pts {
+		seenBefore := false
+		for j := 0; j < i; j++ {
+			if pts[j] == p {
+				seenBefore = true
+				break
+			}
+		}
+		if !seenBefore {
+			plot(fb, p[0], p[1], c)
+		}
+	}
+}
+
+// FilledCircle draws a filled circle of radius r centered at (cx, cy),
+// using the same midpoint algorithm as Circle to find each row's
+// span.
+func FilledCircle(fb *ssd1306.Framebuffer, cx, cy, r int, c Color) {
+	x, y, err := r, 0, 0
+	// halfWidths records the widest span seen for each row, so that
+	// each row is drawn exactly once, across its full width.
+	halfWidths := map[int]int{}
+
+	widen := func(ry, half int) {
+		if w, ok := halfWidths[ry]; !ok || half > w {
+			halfWidths[ry] = half
+		}
+	}
+
+	for y <= x {
+		widen(cy+y, x)
+		widen(cy-y, x)
+		widen(cy+x, y)
+		widen(cy-x, y)
+
+		err += 2*y + 1
+		y++
+		if 2*(err-x)+1 > 0 {
+			err += 1 - 2*x
+			x--
+		}
+	}
+
+	for ry, half := range halfWidths {
+		Line(fb, cx-half, ry, cx+half, ry, c)
+	}
+}
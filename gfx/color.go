@@ -0,0 +1,32 @@
+// Package gfx provides Bresenham/midpoint-based drawing primitives
+// that operate directly on an ssd1306.Framebuffer's 1-bit pixels.
+package gfx
+
+import "github.com/apparentlymart/go-ssd1306/ssd1306"
+
+// Color selects how a primitive combines with whatever is already in
+// the Framebuffer at each pixel it touches.
+type Color int
+
+const (
+	// Off turns each touched pixel off.
+	Off Color = iota
+	// On turns each touched pixel on.
+	On
+	// XOR flips each touched pixel, which is useful for drawing
+	// simple animations without needing to first erase the previous
+	// frame.
+	XOR
+)
+
+// plot applies c to a single pixel.
+func plot(fb *ssd1306.Framebuffer, x, y int, c Color) {
+	switch c {
+	case On:
+		fb.SetPixel(x, y, true)
+	case Off:
+		fb.SetPixel(x, y, false)
+	case XOR:
+		fb.SetPixel(x, y, !fb.Pixel(x, y))
+	}
+}
@@ -0,0 +1,102 @@
+package gfx
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/apparentlymart/go-ssd1306/ssd1306"
+)
+
+func TestLineDrawsBothEndpointsInclusive(t *testing.T) {
+	fb := ssd1306.NewFramebuffer(16, 8)
+	Line(fb, 2, 0, 5, 0, On)
+
+	for x := 2; x <= 5; x++ {
+		if !fb.Pixel(x, 0) {
+			t.Errorf("expected pixel (%d, 0) to be on", x)
+		}
+	}
+	if fb.Pixel(1, 0) || fb.Pixel(6, 0) {
+		t.Error("Line drew outside its endpoints")
+	}
+}
+
+func TestRectDoesNotDoubleXorCorners(t *testing.T) {
+	fb := ssd1306.NewFramebuffer(16, 16)
+	Rect(fb, 1, 1, 6, 6, XOR)
+
+	corners := [][2]int{{1, 1}, {6, 1}, {6, 6}, {1, 6}}
+	for _, p := range corners {
+		if !fb.Pixel(p[0], p[1]) {
+			t.Errorf("corner (%d, %d) was left off: shared endpoint was plotted twice and canceled out under XOR", p[0], p[1])
+		}
+	}
+	// A midpoint of each edge should also be on.
+	edgeMidpoints := [][2]int{{3, 1}, {6, 3}, {3, 6}, {1, 3}}
+	for _, p := range edgeMidpoints {
+		if !fb.Pixel(p[0], p[1]) {
+			t.Errorf("edge pixel (%d, %d) was left off", p[0], p[1])
+		}
+	}
+}
+
+func TestTriangleDoesNotDoubleXorVertices(t *testing.T) {
+	fb := ssd1306.NewFramebuffer(16, 16)
+	Triangle(fb, 1, 1, 10, 1, 5, 8, XOR)
+
+	vertices := [][2]int{{1, 1}, {10, 1}, {5, 8}}
+	for _, p := range vertices {
+		if !fb.Pixel(p[0], p[1]) {
+			t.Errorf("vertex (%d, %d) was left off: shared endpoint was plotted twice and canceled out under XOR", p[0], p[1])
+		}
+	}
+}
+
+func TestCircleDoesNotDoubleXorCollapsedOctants(t *testing.T) {
+	fb := ssd1306.NewFramebuffer(32, 32)
+	cx, cy, r := 16, 16, 6
+	Circle(fb, cx, cy, r, XOR)
+
+	// The four "cardinal" points come from the y=0 iteration, where
+	// all eight octant points collapse onto just these four.
+	cardinals := [][2]int{
+		{cx + r, cy}, {cx - r, cy}, {cx, cy + r}, {cx, cy - r},
+	}
+	for _, p := range cardinals {
+		if !fb.Pixel(p[0], p[1]) {
+			t.Errorf("cardinal point (%d, %d) was left off: collapsed octants canceled out under XOR", p[0], p[1])
+		}
+	}
+}
+
+func TestFilledCircleDoesNotDoubleXorSharedRows(t *testing.T) {
+	fb := ssd1306.NewFramebuffer(32, 32)
+	cx, cy, r := 16, 16, 6
+	FilledCircle(fb, cx, cy, r, XOR)
+
+	if !fb.Pixel(cx, cy) {
+		t.Error("center pixel was left off: the y=0 row was drawn twice and canceled out under XOR")
+	}
+}
+
+func TestBitmapCopiesOnPixels(t *testing.T) {
+	img := image.NewGray(image.Rect(0, 0, 2, 2))
+	img.SetGray(0, 0, color.Gray{Y: 255})
+	img.SetGray(1, 0, color.Gray{Y: 0})
+	img.SetGray(0, 1, color.Gray{Y: 0})
+	img.SetGray(1, 1, color.Gray{Y: 255})
+
+	fb := ssd1306.NewFramebuffer(8, 8)
+	Bitmap(fb, 2, 2, img)
+
+	want := map[[2]int]bool{
+		{2, 2}: true, {3, 2}: false,
+		{2, 3}: false, {3, 3}: true,
+	}
+	for p, on := range want {
+		if got := fb.Pixel(p[0], p[1]); got != on {
+			t.Errorf("pixel (%d, %d): got %v, want %v", p[0], p[1], got, on)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package gfx
+
+import "github.com/apparentlymart/go-ssd1306/ssd1306"
+
+// Line draws a straight line from (x0, y0) to (x1, y1), inclusive of
+// both endpoints, using Bresenham's line algorithm.
+func Line(fb *ssd1306.Framebuffer, x0, y0, x1, y1 int, c Color) {
+	line(fb, x0, y0, x1, y1, c, true)
+}
+
+// line is Line's implementation, with an additional includeLast flag
+// that omits the (x1, y1) endpoint when false. Composite shapes made
+// of several connected lines (Rect, Triangle) use this to plot each
+// shared vertex exactly once: with Color XOR, plotting the same pixel
+// twice cancels it back out.
+func line(fb *ssd1306.Framebuffer, x0, y0, x1, y1 int, c Color, includeLast bool) {
+	dx := abs(x1 - x0)
+	dy := abs(y1 - y0)
+	sx := 1
+	if x0 >= x1 {
+		sx = -1
+	}
+	sy := 1
+	if y0 >= y1 {
+		sy = -1
+	}
+	err := dx - dy
+
+	for {
+		if x0 == x1 && y0 == y1 {
+			if includeLast {
+				plot(fb, x0, y0, c)
+			}
+			break
+		}
+		plot(fb, x0, y0, c)
+		e2 := 2 * err
+		if e2 > -dy {
+			err -= dy
+			x0 += sx
+		}
+		if e2 < dx {
+			err += dx
+			y0 += sy
+		}
+	}
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,27 @@
+package gfx
+
+import "github.com/apparentlymart/go-ssd1306/ssd1306"
+
+// Rect draws the outline of an axis-aligned rectangle with corners
+// (x0, y0) and (x1, y1), inclusive.
+//
+// Each edge omits its trailing corner, since adjacent edges share an
+// endpoint: without that, Color XOR would plot each corner pixel
+// twice and cancel it back out.
+func Rect(fb *ssd1306.Framebuffer, x0, y0, x1, y1 int, c Color) {
+	line(fb, x0, y0, x1, y0, c, false)
+	line(fb, x1, y0, x1, y1, c, false)
+	line(fb, x1, y1, x0, y1, c, false)
+	line(fb, x0, y1, x0, y0, c, false)
+}
+
+// FilledRect draws a filled axis-aligned rectangle with corners
+// (x0, y0) and (x1, y1), inclusive.
+func FilledRect(fb *ssd1306.Framebuffer, x0, y0, x1, y1 int, c Color) {
+	if y0 > y1 {
+		y0, y1 = y1, y0
+	}
+	for y := y0; y <= y1; y++ {
+		Line(fb, x0, y, x1, y, c)
+	}
+}
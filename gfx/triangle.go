@@ -0,0 +1,15 @@
+package gfx
+
+import "github.com/apparentlymart/go-ssd1306/ssd1306"
+
+// Triangle draws the outline of a triangle with the given three
+// vertices.
+//
+// Each edge omits its trailing vertex, since adjacent edges share an
+// endpoint: without that, Color XOR would plot each vertex pixel
+// twice and cancel it back out.
+func Triangle(fb *ssd1306.Framebuffer, x0, y0, x1, y1, x2, y2 int, c Color) {
+	line(fb, x0, y0, x1, y1, c, false)
+	line(fb, x1, y1, x2, y2, c, false)
+	line(fb, x2, y2, x0, y0, c, false)
+}
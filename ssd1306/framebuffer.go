@@ -0,0 +1,205 @@
+package ssd1306
+
+import (
+	"image"
+	"image/color"
+)
+
+// Framebuffer is an in-memory representation of the contents of an
+// SSD1306 display, stored in the same page/column layout that the
+// controller itself uses: each byte represents a vertical strip of
+// eight pixels within one "page" (a group of eight rows), with the
+// least-significant bit at the top.
+//
+// Framebuffer implements image.Image and draw.Image, so it can be used
+// as a target for the standard library's image/draw package and other
+// code that works in terms of those interfaces. It also provides
+// Flush, which sends to the given Display only the bounding rectangle
+// of pages and columns that have changed since the previous call.
+type Framebuffer struct {
+	width  int
+	height int
+	pages  int
+	pix    []byte
+
+	dirty      bool
+	minDirtyX  int
+	maxDirtyX  int
+	minDirtyPg int
+	maxDirtyPg int
+}
+
+// NewFramebuffer allocates a new Framebuffer for a display of the given
+// width and height. height must be a multiple of 8, since the SSD1306
+// addresses memory in pages of eight rows.
+func NewFramebuffer(width, height int) *Framebuffer {
+	if height%8 != 0 {
+		panic("ssd1306: framebuffer height must be a multiple of 8")
+	}
+	pages := height / 8
+	return &Framebuffer{
+		width:  width,
+		height: height,
+		pages:  pages,
+		pix:    make([]byte, width*pages),
+	}
+}
+
+// Color is a 1-bit color: either pixel-on or pixel-off.
+type Color bool
+
+// On and Off are the two values a Color can take.
+const (
+	On  Color = true
+	Off Color = false
+)
+
+// ColorModel implements image.Image.
+func (fb *Framebuffer) ColorModel() color.Model {
+	return monoModel
+}
+
+// Bounds implements image.Image. Its origin is always (0, 0) and its
+// size matches the geometry the Framebuffer was constructed with.
+func (fb *Framebuffer) Bounds() image.Rectangle {
+	return image.Rect(0, 0, fb.width, fb.height)
+}
+
+// At implements image.Image.
+func (fb *Framebuffer) At(x, y int) color.Color {
+	return Color(fb.Pixel(x, y))
+}
+
+// Set implements draw.Image.
+func (fb *Framebuffer) Set(x, y int, c color.Color) {
+	r, _, _, _ := c.RGBA()
+	fb.SetPixel(x, y, r != 0)
+}
+
+// Pixel returns whether the pixel at (x, y) is on. Coordinates outside
+// of Bounds() always report off.
+func (fb *Framebuffer) Pixel(x, y int) bool {
+	if x < 0 || x >= fb.width || y < 0 || y >= fb.height {
+		return false
+	}
+	page := y / 8
+	bit := uint(y % 8)
+	return fb.pix[page*fb.width+x]&(1<<bit) != 0
+}
+
+// SetPixel sets whether the pixel at (x, y) is on. Coordinates outside
+// of Bounds() are silently ignored.
+func (fb *Framebuffer) SetPixel(x, y int, on bool) {
+	if x < 0 || x >= fb.width || y < 0 || y >= fb.height {
+		return
+	}
+	page := y / 8
+	bit := uint(y % 8)
+	idx := page*fb.width + x
+	before := fb.pix[idx]
+	if on {
+		fb.pix[idx] |= 1 << bit
+	} else {
+		fb.pix[idx] &^= 1 << bit
+	}
+	if fb.pix[idx] != before {
+		fb.markDirty(x, page)
+	}
+}
+
+// Clear turns every pixel in the framebuffer off.
+func (fb *Framebuffer) Clear() {
+	for i := range fb.pix {
+		fb.pix[i] = 0
+	}
+	fb.dirty = true
+	fb.minDirtyX, fb.maxDirtyX = 0, fb.width-1
+	fb.minDirtyPg, fb.maxDirtyPg = 0, fb.pages-1
+}
+
+func (fb *Framebuffer) markDirty(x, page int) {
+	if !fb.dirty {
+		fb.dirty = true
+		fb.minDirtyX, fb.maxDirtyX = x, x
+		fb.minDirtyPg, fb.maxDirtyPg = page, page
+		return
+	}
+	if x < fb.minDirtyX {
+		fb.minDirtyX = x
+	}
+	if x > fb.maxDirtyX {
+		fb.maxDirtyX = x
+	}
+	if page < fb.minDirtyPg {
+		fb.minDirtyPg = page
+	}
+	if page > fb.maxDirtyPg {
+		fb.maxDirtyPg = page
+	}
+}
+
+// Flush sends the smallest bounding rectangle of pages and columns that
+// have changed since the last Flush to disp, using horizontal
+// addressing mode. If nothing has changed since the last Flush, Flush
+// does nothing and returns nil.
+//
+// The SSD1306 is a write-only device, so resending the whole frame on
+// every update wastes bus bandwidth that slower links (particularly
+// I2C) can't spare; tracking dirty regions keeps each Flush
+// proportional to the amount of the display that actually changed.
+func (fb *Framebuffer) Flush(disp Display) error {
+	if !fb.dirty {
+		return nil
+	}
+
+	startCol := byte(fb.minDirtyX)
+	endCol := byte(fb.maxDirtyX)
+	startPage := byte(fb.minDirtyPg)
+	endPage := byte(fb.maxDirtyPg)
+
+	if err := disp.SetMemoryAddressingMode(HorizontalAddressing); err != nil {
+		return err
+	}
+	if err := disp.SetColumnAddressRange(startCol, endCol); err != nil {
+		return err
+	}
+	if err := disp.SetPageAddressRange(startPage, endPage); err != nil {
+		return err
+	}
+
+	width := int(endCol-startCol) + 1
+	buf := make([]byte, 0, width*(int(endPage-startPage)+1))
+	for page := int(startPage); page <= int(endPage); page++ {
+		rowStart := page*fb.width + int(startCol)
+		buf = append(buf, fb.pix[rowStart:rowStart+width]...)
+	}
+
+	if err := disp.WriteData(buf); err != nil {
+		return err
+	}
+
+	fb.dirty = false
+	return nil
+}
+
+// monoColorModel converts arbitrary colors to the 1-bit Color type
+// based on whether they are perceptibly "on" (non-black).
+type monoColorModel struct{}
+
+func (monoColorModel) Convert(c color.Color) color.Color {
+	if existing, ok := c.(Color); ok {
+		return existing
+	}
+	r, g, b, _ := c.RGBA()
+	return Color(r != 0 || g != 0 || b != 0)
+}
+
+var monoModel = color.ModelFunc(monoColorModel{}.Convert)
+
+// RGBA implements color.Color.
+func (c Color) RGBA() (r, g, b, a uint32) {
+	if c {
+		return 0xffff, 0xffff, 0xffff, 0xffff
+	}
+	return 0, 0, 0, 0xffff
+}
@@ -0,0 +1,130 @@
+package ssd1306
+
+// VccSource identifies where the display panel draws its OLED supply
+// voltage from, which affects both the charge pump and precharge
+// settings Init selects.
+type VccSource int
+
+const (
+	// SwitchCapVCC indicates that the panel generates its own OLED
+	// supply voltage using the SSD1306's internal charge pump. This is
+	// the configuration used by the vast majority of hobbyist panels.
+	SwitchCapVCC VccSource = iota
+
+	// ExternalVCC indicates that the panel is supplied with OLED
+	// voltage externally, so the internal charge pump should stay
+	// disabled.
+	ExternalVCC
+)
+
+func (v VccSource) chargePumpSetting() ChargePumpSetting {
+	if v == ExternalVCC {
+		return ChargePumpDisabled
+	}
+	return ChargePumpEnabled
+}
+
+func (v VccSource) prechargePeriod() (phase1Ticks, phase2Ticks byte) {
+	if v == ExternalVCC {
+		return 0x2, 0x2
+	}
+	return 0x1, 0xF
+}
+
+// PanelConfig describes the geometry and power characteristics of a
+// particular SSD1306 panel variant, as needed to run the recommended
+// power-on initialization sequence via Init.
+//
+// Rather than constructing a PanelConfig by hand, most callers should
+// start from one of the bundled presets (Panel128x64, Panel128x32,
+// Panel96x16) and override individual fields such as Contrast or
+// VccSource if needed.
+type PanelConfig struct {
+	// Width and Height are the panel's resolution in pixels. Height
+	// must be a multiple of 8.
+	Width, Height int
+
+	// ComPins selects the COM pin hardware configuration, which
+	// depends on how the panel's COM lines are wired internally.
+	// Taller panels (128x64) use AlternativeComPinConfig; short,
+	// wide panels (128x32, 96x16) use SequentialComPinConfig.
+	ComPins ComPinConfig
+
+	// Contrast is the initial contrast level to apply during Init.
+	Contrast byte
+
+	// VccSource identifies where the panel's OLED supply voltage
+	// comes from. Most panels are SwitchCapVCC.
+	VccSource VccSource
+}
+
+// Panel128x64 is the PanelConfig for the common 128x64 SSD1306 panel
+// variant, powered from the internal charge pump.
+var Panel128x64 = PanelConfig{
+	Width:     128,
+	Height:    64,
+	ComPins:   AlternativeComPinConfig,
+	Contrast:  0xCF,
+	VccSource: SwitchCapVCC,
+}
+
+// Panel128x32 is the PanelConfig for the common 128x32 SSD1306 panel
+// variant, powered from the internal charge pump.
+var Panel128x32 = PanelConfig{
+	Width:     128,
+	Height:    32,
+	ComPins:   SequentialComPinConfig,
+	Contrast:  0x8F,
+	VccSource: SwitchCapVCC,
+}
+
+// Panel96x16 is the PanelConfig for the common 96x16 SSD1306 panel
+// variant, powered from the internal charge pump.
+var Panel96x16 = PanelConfig{
+	Width:     96,
+	Height:    16,
+	ComPins:   SequentialComPinConfig,
+	Contrast:  0x8F,
+	VccSource: SwitchCapVCC,
+}
+
+// Init runs the full recommended power-on sequence for the panel
+// described by cfg: reset, display-off, clock configuration,
+// multiplex ratio, offset, start line, charge pump, addressing mode,
+// segment remap, COM scan direction, COM pin configuration, contrast,
+// precharge period, VCOMH deselect level, resuming display from RAM
+// content, uninverting, and finally turning the display on.
+//
+// This replaces the roughly fifteen setter calls, in a very particular
+// order and with values that depend on the panel geometry, that the
+// SSD1306 datasheet otherwise requires callers to get right themselves.
+func (disp *display) Init(cfg PanelConfig) error {
+	phase1, phase2 := cfg.VccSource.prechargePeriod()
+
+	steps := []func() error{
+		disp.Reset,
+		disp.TurnOff,
+		func() error { return disp.ConfigureClock(0x0, 0x8) },
+		func() error { return disp.SetMultiplexRatio(byte(cfg.Height - 1)) },
+		func() error { return disp.SetOffset(0x00) },
+		func() error { return disp.SetStartLine(0x00) },
+		func() error { return disp.SetChargePump(cfg.VccSource.chargePumpSetting()) },
+		func() error { return disp.SetMemoryAddressingMode(HorizontalAddressing) },
+		func() error { return disp.SetSegmentRemap(Map127ToSeg0) },
+		func() error { return disp.SetComOutputScanDirection(ScanDescending) },
+		func() error { return disp.ConfigureComPinsHardware(cfg.ComPins, DisableComLeftRightRemap) },
+		func() error { return disp.SetContrast(cfg.Contrast) },
+		func() error { return disp.SetPrechargePeriod(phase1, phase2) },
+		func() error { return disp.SetVcomhDeselectLevel(VccTimesPoint77) },
+		disp.ForceEntireDisplayOn,
+		disp.Uninvert,
+		disp.TurnOn,
+	}
+
+	for _, step := range steps {
+		if err := step(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package ssd1306
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/apparentlymart/go-gpio/gpio"
+)
+
+func TestInitSendsExpectedSequenceForPanel128x64(t *testing.T) {
+	disp, spiDev, _, resetPin := newTestDisplay()
+
+	if err := disp.Init(Panel128x64); err != nil {
+		t.Fatalf("Init() returned error: %v", err)
+	}
+
+	wantResetValues := []gpio.Value{gpio.High, gpio.Low, gpio.High}
+	if !reflect.DeepEqual(resetPin.values, wantResetValues) {
+		t.Errorf("wrong reset pin sequence: got %#v, want %#v", resetPin.values, wantResetValues)
+	}
+
+	wantWrites := [][]byte{
+		{0xAE},       // TurnOff
+		{0xD5, 0x80}, // ConfigureClock(0x0, 0x8)
+		{0xA8, 63},   // SetMultiplexRatio(Height-1)
+		{0xD3, 0x00}, // SetOffset(0x00)
+		{0x40},       // SetStartLine(0x00)
+		{0x8D, 0x10}, // SetChargePump(ChargePumpEnabled)
+		{0x20, 0x00}, // SetMemoryAddressingMode(HorizontalAddressing)
+		{0xA1},       // SetSegmentRemap(Map127ToSeg0)
+		{0xC8},       // SetComOutputScanDirection(ScanDescending)
+		{0xDA, 0x12}, // ConfigureComPinsHardware(AlternativeComPinConfig, DisableComLeftRightRemap)
+		{0x81, 0xCF}, // SetContrast(Panel128x64.Contrast)
+		{0xD9, 0xF1}, // SetPrechargePeriod for SwitchCapVCC
+		{0xDB, 0x20}, // SetVcomhDeselectLevel(VccTimesPoint77)
+		{0xA4},       // ForceEntireDisplayOn
+		{0xA6},       // Uninvert
+		{0xAF},       // TurnOn
+	}
+	if !reflect.DeepEqual(spiDev.writes, wantWrites) {
+		t.Errorf("wrong bytes on wire: got %#v, want %#v", spiDev.writes, wantWrites)
+	}
+}
+
+func TestInitUsesExternalVCCPrechargeAndChargePump(t *testing.T) {
+	disp, spiDev, _, _ := newTestDisplay()
+
+	cfg := Panel96x16
+	cfg.VccSource = ExternalVCC
+
+	if err := disp.Init(cfg); err != nil {
+		t.Fatalf("Init() returned error: %v", err)
+	}
+
+	var gotChargePump, gotPrecharge []byte
+	for _, w := range spiDev.writes {
+		switch {
+		case len(w) == 2 && w[0] == 0x8D:
+			gotChargePump = w
+		case len(w) == 2 && w[0] == 0xD9:
+			gotPrecharge = w
+		}
+	}
+
+	if want := []byte{0x8D, byte(ChargePumpDisabled)}; !reflect.DeepEqual(gotChargePump, want) {
+		t.Errorf("wrong charge pump bytes for ExternalVCC: got %#v, want %#v", gotChargePump, want)
+	}
+	if want := []byte{0xD9, 0x22}; !reflect.DeepEqual(gotPrecharge, want) {
+		t.Errorf("wrong precharge bytes for ExternalVCC: got %#v, want %#v", gotPrecharge, want)
+	}
+}
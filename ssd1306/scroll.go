@@ -0,0 +1,109 @@
+package ssd1306
+
+import "fmt"
+
+// ScrollDirection selects which way a horizontal scroll moves the
+// display contents.
+type ScrollDirection byte
+
+const (
+	ScrollRight ScrollDirection = 0x26
+	ScrollLeft  ScrollDirection = 0x27
+)
+
+// ScrollInterval selects how many frames the display waits between each
+// step of a scroll, as defined by the SSD1306 datasheet.
+type ScrollInterval byte
+
+const (
+	ScrollEvery5Frames   ScrollInterval = 0x00
+	ScrollEvery64Frames  ScrollInterval = 0x01
+	ScrollEvery128Frames ScrollInterval = 0x02
+	ScrollEvery256Frames ScrollInterval = 0x03
+	ScrollEvery3Frames   ScrollInterval = 0x04
+	ScrollEvery4Frames   ScrollInterval = 0x05
+	ScrollEvery25Frames  ScrollInterval = 0x06
+	ScrollEvery2Frames   ScrollInterval = 0x07
+)
+
+// SetupHorizontalScroll configures a pure horizontal scroll over the
+// given inclusive page range, without starting it. Call ActivateScroll
+// to begin scrolling once setup is complete.
+func (disp *display) SetupHorizontalScroll(direction ScrollDirection, startPage, endPage byte, interval ScrollInterval) error {
+	if disp.scrolling {
+		return fmt.Errorf("ssd1306: cannot set up a scroll while one is active; call DeactivateScroll first")
+	}
+	return disp.sendCommand([]byte{
+		byte(direction),
+		0x00,
+		startPage,
+		byte(interval),
+		endPage,
+		0x00,
+		0xFF,
+	})
+}
+
+// SetupVerticalAndHorizontalScroll configures a combined vertical and
+// horizontal scroll over the given inclusive page range, offsetting the
+// vertical component by verticalOffset rows per step (1-63), without
+// starting it. Call ActivateScroll to begin scrolling once setup is
+// complete.
+//
+// direction must be ScrollRight or ScrollLeft; the SSD1306 only
+// supports diagonal scrolling in those two directions, not pure
+// vertical scrolling.
+func (disp *display) SetupVerticalAndHorizontalScroll(direction ScrollDirection, startPage, endPage byte, interval ScrollInterval, verticalOffset byte) error {
+	if disp.scrolling {
+		return fmt.Errorf("ssd1306: cannot set up a scroll while one is active; call DeactivateScroll first")
+	}
+	if verticalOffset < 1 || verticalOffset > 63 {
+		return fmt.Errorf("ssd1306: verticalOffset must be in the range 1-63, got %d", verticalOffset)
+	}
+	var opcode byte
+	switch direction {
+	case ScrollRight:
+		opcode = 0x29
+	case ScrollLeft:
+		opcode = 0x2A
+	default:
+		return fmt.Errorf("ssd1306: invalid scroll direction for vertical+horizontal scroll")
+	}
+	return disp.sendCommand([]byte{
+		opcode,
+		0x00,
+		startPage,
+		byte(interval),
+		endPage,
+		verticalOffset,
+	})
+}
+
+// SetVerticalScrollArea sets the number of rows at the top of the
+// display that are fixed (not affected by vertical scrolling) and the
+// number of rows below that which scroll.
+func (disp *display) SetVerticalScrollArea(topFixed, scrollRows byte) error {
+	return disp.sendCommand([]byte{0xA3, topFixed, scrollRows})
+}
+
+// ActivateScroll starts whichever scroll was most recently configured
+// with SetupHorizontalScroll or SetupVerticalAndHorizontalScroll.
+func (disp *display) ActivateScroll() error {
+	if err := disp.sendCommand([]byte{0x2F}); err != nil {
+		return err
+	}
+	disp.scrolling = true
+	return nil
+}
+
+// DeactivateScroll stops any active scroll. The datasheet requires this
+// to be called before reconfiguring scroll parameters or writing to
+// display RAM directly, since doing so while scrolling is active can
+// corrupt the RAM contents.
+func (disp *display) DeactivateScroll() error {
+	if err := disp.sendCommand([]byte{0x2E}); err != nil {
+		return err
+	}
+	disp.scrolling = false
+	return nil
+}
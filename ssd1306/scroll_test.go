@@ -0,0 +1,127 @@
+package ssd1306
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSetupHorizontalScrollSendsCorrectBytes(t *testing.T) {
+	disp, spiDev, _, _ := newTestDisplay()
+
+	if err := disp.SetupHorizontalScroll(ScrollRight, 0x00, 0x07, ScrollEvery2Frames); err != nil {
+		t.Fatalf("SetupHorizontalScroll() returned error: %v", err)
+	}
+
+	wantWrites := [][]byte{{0x26, 0x00, 0x00, 0x07, 0x07, 0x00, 0xFF}}
+	if !reflect.DeepEqual(spiDev.writes, wantWrites) {
+		t.Errorf("wrong bytes on wire: got %#v, want %#v", spiDev.writes, wantWrites)
+	}
+}
+
+func TestSetupHorizontalScrollRejectedWhileScrolling(t *testing.T) {
+	disp, _, _, _ := newTestDisplay()
+	disp.scrolling = true
+
+	if err := disp.SetupHorizontalScroll(ScrollRight, 0x00, 0x07, ScrollEvery2Frames); err == nil {
+		t.Error("expected an error when setting up a scroll while one is active, got nil")
+	}
+}
+
+func TestSetupVerticalAndHorizontalScrollSendsCorrectBytes(t *testing.T) {
+	disp, spiDev, _, _ := newTestDisplay()
+
+	if err := disp.SetupVerticalAndHorizontalScroll(ScrollLeft, 0x00, 0x07, ScrollEvery2Frames, 0x01); err != nil {
+		t.Fatalf("SetupVerticalAndHorizontalScroll() returned error: %v", err)
+	}
+
+	wantWrites := [][]byte{{0x2A, 0x00, 0x00, 0x07, 0x07, 0x01}}
+	if !reflect.DeepEqual(spiDev.writes, wantWrites) {
+		t.Errorf("wrong bytes on wire: got %#v, want %#v", spiDev.writes, wantWrites)
+	}
+}
+
+func TestSetupVerticalAndHorizontalScrollRejectsInvalidDirection(t *testing.T) {
+	disp, _, _, _ := newTestDisplay()
+
+	if err := disp.SetupVerticalAndHorizontalScroll(ScrollDirection(0xFF), 0x00, 0x07, ScrollEvery2Frames, 0x01); err == nil {
+		t.Error("expected an error for an invalid scroll direction, got nil")
+	}
+}
+
+func TestSetupVerticalAndHorizontalScrollRejectsOutOfRangeOffset(t *testing.T) {
+	disp, _, _, _ := newTestDisplay()
+
+	if err := disp.SetupVerticalAndHorizontalScroll(ScrollRight, 0x00, 0x07, ScrollEvery2Frames, 0); err == nil {
+		t.Error("expected an error for verticalOffset=0, got nil")
+	}
+	if err := disp.SetupVerticalAndHorizontalScroll(ScrollRight, 0x00, 0x07, ScrollEvery2Frames, 64); err == nil {
+		t.Error("expected an error for verticalOffset=64, got nil")
+	}
+}
+
+func TestSetupVerticalAndHorizontalScrollRejectedWhileScrolling(t *testing.T) {
+	disp, _, _, _ := newTestDisplay()
+	disp.scrolling = true
+
+	if err := disp.SetupVerticalAndHorizontalScroll(ScrollRight, 0x00, 0x07, ScrollEvery2Frames, 0x01); err == nil {
+		t.Error("expected an error when setting up a scroll while one is active, got nil")
+	}
+}
+
+func TestSetVerticalScrollAreaSendsCorrectBytes(t *testing.T) {
+	disp, spiDev, _, _ := newTestDisplay()
+
+	if err := disp.SetVerticalScrollArea(0x00, 0x40); err != nil {
+		t.Fatalf("SetVerticalScrollArea() returned error: %v", err)
+	}
+
+	wantWrites := [][]byte{{0xA3, 0x00, 0x40}}
+	if !reflect.DeepEqual(spiDev.writes, wantWrites) {
+		t.Errorf("wrong bytes on wire: got %#v, want %#v", spiDev.writes, wantWrites)
+	}
+}
+
+func TestActivateScrollSendsCorrectBytesAndTracksState(t *testing.T) {
+	disp, spiDev, _, _ := newTestDisplay()
+
+	if err := disp.ActivateScroll(); err != nil {
+		t.Fatalf("ActivateScroll() returned error: %v", err)
+	}
+
+	wantWrites := [][]byte{{0x2F}}
+	if !reflect.DeepEqual(spiDev.writes, wantWrites) {
+		t.Errorf("wrong bytes on wire: got %#v, want %#v", spiDev.writes, wantWrites)
+	}
+	if !disp.scrolling {
+		t.Error("expected disp.scrolling to be true after ActivateScroll()")
+	}
+}
+
+func TestDeactivateScrollSendsCorrectBytesAndTracksState(t *testing.T) {
+	disp, spiDev, _, _ := newTestDisplay()
+	disp.scrolling = true
+
+	if err := disp.DeactivateScroll(); err != nil {
+		t.Fatalf("DeactivateScroll() returned error: %v", err)
+	}
+
+	wantWrites := [][]byte{{0x2E}}
+	if !reflect.DeepEqual(spiDev.writes, wantWrites) {
+		t.Errorf("wrong bytes on wire: got %#v, want %#v", spiDev.writes, wantWrites)
+	}
+	if disp.scrolling {
+		t.Error("expected disp.scrolling to be false after DeactivateScroll()")
+	}
+}
+
+func TestDeactivateScrollAllowsReconfiguring(t *testing.T) {
+	disp, _, _, _ := newTestDisplay()
+	disp.scrolling = true
+
+	if err := disp.DeactivateScroll(); err != nil {
+		t.Fatalf("DeactivateScroll() returned error: %v", err)
+	}
+	if err := disp.SetupHorizontalScroll(ScrollRight, 0x00, 0x07, ScrollEvery2Frames); err != nil {
+		t.Errorf("SetupHorizontalScroll() returned error after DeactivateScroll(): %v", err)
+	}
+}
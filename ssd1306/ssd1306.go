@@ -1,16 +1,20 @@
 // Package ssd1306 is a driver for SSD1306 monochrome OLED display modules.
 //
-// It works in terms of GPIO and SPI implementations provided elsewhere,
-// implementing the go-gpio and go-spi interfaces. To use this driver on
-// an embedded Linux system you may be able to use the GPIO and SPI
-// implementations in go-linuxgpio and go-linuxspi, as long as your kernel
-// has drivers that expose the hardware GPIO and SPI pins to userspace.
+// It works in terms of GPIO, SPI and I2C implementations provided
+// elsewhere, implementing the go-gpio, go-spi and go-i2c interfaces. To
+// use this driver on an embedded Linux system you may be able to use
+// the GPIO, SPI and I2C implementations in go-linuxgpio, go-linuxspi
+// and go-linuxi2c, as long as your kernel has drivers that expose the
+// hardware pins and buses to userspace.
+//
+// The display itself is addressed over a Transport, which abstracts
+// over the SPI and I2C wiring variants that SSD1306 modules are
+// commonly sold with. See NewSPITransport and NewI2CTransport.
 package ssd1306
 
 import (
 	"fmt"
 	"github.com/apparentlymart/go-gpio/gpio"
-	"github.com/apparentlymart/go-spi/spi"
 	"time"
 )
 
@@ -68,6 +72,11 @@ const (
 type Display interface {
 	Reset() error
 
+	// Init runs the recommended power-on sequence for the given panel
+	// configuration. Most callers should call this instead of driving
+	// the individual setter methods below directly.
+	Init(cfg PanelConfig) error
+
 	Invert() error
 	Uninvert() error
 	TurnOn() error
@@ -86,16 +95,34 @@ type Display interface {
 	SetVcomhDeselectLevel(level VcomhDeselectLevel) error
 	ForceEntireDisplayOn() error
 	StopForcingEntireDisplayOn() error
+
+	SetColumnAddressRange(start, end byte) error
+	SetPageAddressRange(start, end byte) error
+	WriteData(data []byte) error
+
+	SetupHorizontalScroll(direction ScrollDirection, startPage, endPage byte, interval ScrollInterval) error
+	SetupVerticalAndHorizontalScroll(direction ScrollDirection, startPage, endPage byte, interval ScrollInterval, verticalOffset byte) error
+	SetVerticalScrollArea(topFixed, scrollRows byte) error
+	ActivateScroll() error
+	DeactivateScroll() error
 }
 
 type display struct {
-	spi      spi.WritableDevice
-	dcPin    gpio.ValueSetter
-	resetPin gpio.ValueSetter
+	transport Transport
+	resetPin  gpio.ValueSetter
+	scrolling bool
 }
 
-func NewDisplay(spi spi.WritableDevice, dcPin gpio.ValueSetter, resetPin gpio.ValueSetter) Display {
-	return &display{spi, dcPin, resetPin}
+// NewDisplay creates a new Display that communicates over the given
+// Transport, using resetPin to drive the controller's hardware reset
+// line.
+//
+// Existing callers constructed around the SPI-only API can keep
+// working unchanged by wrapping their spi.WritableDevice and D/C pin in
+// NewSPITransport. Callers wired up to an I2C-connected module should
+// use NewI2CTransport instead.
+func NewDisplay(transport Transport, resetPin gpio.ValueSetter) Display {
+	return &display{transport: transport, resetPin: resetPin}
 }
 
 func (disp *display) Reset() error {
@@ -118,16 +145,26 @@ func (disp *display) Reset() error {
 }
 
 func (disp *display) sendCommand(data []byte) error {
-	disp.dcPin.SetValue(gpio.Low)
-	n, err := disp.spi.Write(data)
-	if err != nil {
-		return err
-	}
-	if n != len(data) {
-		return fmt.Errorf("Short write")
-	}
-	//disp.dcPin.SetValue(gpio.High)
-	return nil
+	return disp.transport.SendCommand(data)
+}
+
+// WriteData sends raw pixel data to the display, as used to flush
+// framebuffer contents while in horizontal, vertical or page
+// addressing mode.
+func (disp *display) WriteData(data []byte) error {
+	return disp.transport.SendData(data)
+}
+
+// SetColumnAddressRange sets the start and end column addresses used by
+// horizontal and vertical addressing mode. Both are in the range 0-127.
+func (disp *display) SetColumnAddressRange(start, end byte) error {
+	return disp.sendCommand([]byte{0x21, start, end})
+}
+
+// SetPageAddressRange sets the start and end page addresses used by
+// horizontal and vertical addressing mode. Both are in the range 0-7.
+func (disp *display) SetPageAddressRange(start, end byte) error {
+	return disp.sendCommand([]byte{0x22, start, end})
 }
 
 func (disp *display) Invert() error {
@@ -150,9 +187,29 @@ func (disp *display) SetChargePump(setting ChargePumpSetting) error {
 	return disp.sendCommand([]byte{0x8D, byte(setting)})
 }
 
+// ConfigureClock sets the display clock divide ratio and oscillator
+// frequency (opcode 0xD5, SETDISPLAYCLOCKDIV). Both clkDivRatio and
+// oscFreqSetting are 4-bit fields, so each must be in the range 0-15;
+// passing a larger value would silently overflow into the neighboring
+// nibble of the packed byte, so it's rejected instead.
 func (disp *display) ConfigureClock(clkDivRatio byte, oscFreqSetting byte) error {
-	packedValue := (oscFreqSetting << 4) | clkDivRatio
-	return disp.sendCommand([]byte{0x8D, packedValue})
+	packedValue, err := packClockDivide(clkDivRatio, oscFreqSetting)
+	if err != nil {
+		return err
+	}
+	return disp.sendCommand([]byte{0xD5, packedValue})
+}
+
+// packClockDivide validates and packs the two 4-bit fields of the
+// SETDISPLAYCLOCKDIV command byte.
+func packClockDivide(clkDivRatio byte, oscFreqSetting byte) (byte, error) {
+	if clkDivRatio > 0x0F {
+		return 0, fmt.Errorf("clkDivRatio must be in the range 0-15, got %d", clkDivRatio)
+	}
+	if oscFreqSetting > 0x0F {
+		return 0, fmt.Errorf("oscFreqSetting must be in the range 0-15, got %d", oscFreqSetting)
+	}
+	return (oscFreqSetting << 4) | clkDivRatio, nil
 }
 
 func (disp *display) ForceEntireDisplayOn() error {
@@ -0,0 +1,151 @@
+package ssd1306
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/apparentlymart/go-gpio/gpio"
+)
+
+// mockSPI is a mock spi.WritableDevice that records every write it is
+// given, so tests can assert on the exact bytes a command method puts
+// on the wire.
+type mockSPI struct {
+	writes [][]byte
+}
+
+func (m *mockSPI) Write(data []byte) (int, error) {
+	buf := make([]byte, len(data))
+	copy(buf, data)
+	m.writes = append(m.writes, buf)
+	return len(data), nil
+}
+
+// mockPin is a mock gpio.ValueSetter that records every value it is
+// set to.
+type mockPin struct {
+	values []gpio.Value
+}
+
+func (p *mockPin) SetValue(v gpio.Value) error {
+	p.values = append(p.values, v)
+	return nil
+}
+
+func newTestDisplay() (*display, *mockSPI, *mockPin, *mockPin) {
+	spiDev := &mockSPI{}
+	dcPin := &mockPin{}
+	resetPin := &mockPin{}
+	disp := NewDisplay(NewSPITransport(spiDev, dcPin), resetPin).(*display)
+	return disp, spiDev, dcPin, resetPin
+}
+
+func TestInvertSendsCorrectBytes(t *testing.T) {
+	disp, spiDev, dcPin, _ := newTestDisplay()
+
+	if err := disp.Invert(); err != nil {
+		t.Fatalf("Invert() returned error: %v", err)
+	}
+
+	wantWrites := [][]byte{{0xA7}}
+	if !reflect.DeepEqual(spiDev.writes, wantWrites) {
+		t.Errorf("wrong bytes on wire: got %#v, want %#v", spiDev.writes, wantWrites)
+	}
+
+	if len(dcPin.values) == 0 || dcPin.values[len(dcPin.values)-1] != gpio.Low {
+		t.Errorf("D/C pin was not held low for a command write: got %#v", dcPin.values)
+	}
+}
+
+func TestWriteDataHoldsDCHigh(t *testing.T) {
+	disp, spiDev, dcPin, _ := newTestDisplay()
+
+	if err := disp.WriteData([]byte{0x01, 0x02}); err != nil {
+		t.Fatalf("WriteData() returned error: %v", err)
+	}
+
+	wantWrites := [][]byte{{0x01, 0x02}}
+	if !reflect.DeepEqual(spiDev.writes, wantWrites) {
+		t.Errorf("wrong bytes on wire: got %#v, want %#v", spiDev.writes, wantWrites)
+	}
+
+	if len(dcPin.values) == 0 || dcPin.values[len(dcPin.values)-1] != gpio.High {
+		t.Errorf("D/C pin was not held high for a data write: got %#v", dcPin.values)
+	}
+}
+
+func TestConfigureClockSendsCorrectOpcodeAndBytes(t *testing.T) {
+	disp, spiDev, _, _ := newTestDisplay()
+
+	if err := disp.ConfigureClock(0x3, 0x8); err != nil {
+		t.Fatalf("ConfigureClock() returned error: %v", err)
+	}
+
+	wantWrites := [][]byte{{0xD5, 0x83}}
+	if !reflect.DeepEqual(spiDev.writes, wantWrites) {
+		t.Errorf("wrong bytes on wire: got %#v, want %#v", spiDev.writes, wantWrites)
+	}
+}
+
+func TestConfigureClockRejectsOutOfRangeFields(t *testing.T) {
+	disp, _, _, _ := newTestDisplay()
+
+	if err := disp.ConfigureClock(0x10, 0x0); err == nil {
+		t.Error("expected an error for clkDivRatio=0x10, got nil")
+	}
+	if err := disp.ConfigureClock(0x0, 0x10); err == nil {
+		t.Error("expected an error for oscFreqSetting=0x10, got nil")
+	}
+}
+
+func TestSetChargePumpSendsCorrectBytes(t *testing.T) {
+	disp, spiDev, _, _ := newTestDisplay()
+
+	if err := disp.SetChargePump(ChargePumpEnabled); err != nil {
+		t.Fatalf("SetChargePump() returned error: %v", err)
+	}
+
+	wantWrites := [][]byte{{0x8D, byte(ChargePumpEnabled)}}
+	if !reflect.DeepEqual(spiDev.writes, wantWrites) {
+		t.Errorf("wrong bytes on wire: got %#v, want %#v", spiDev.writes, wantWrites)
+	}
+}
+
+func TestSetColumnAddressRangeSendsCorrectBytes(t *testing.T) {
+	disp, spiDev, _, _ := newTestDisplay()
+
+	if err := disp.SetColumnAddressRange(0, 127); err != nil {
+		t.Fatalf("SetColumnAddressRange() returned error: %v", err)
+	}
+
+	wantWrites := [][]byte{{0x21, 0, 127}}
+	if !reflect.DeepEqual(spiDev.writes, wantWrites) {
+		t.Errorf("wrong bytes on wire: got %#v, want %#v", spiDev.writes, wantWrites)
+	}
+}
+
+func TestSetPageAddressRangeSendsCorrectBytes(t *testing.T) {
+	disp, spiDev, _, _ := newTestDisplay()
+
+	if err := disp.SetPageAddressRange(0, 7); err != nil {
+		t.Fatalf("SetPageAddressRange() returned error: %v", err)
+	}
+
+	wantWrites := [][]byte{{0x22, 0, 7}}
+	if !reflect.DeepEqual(spiDev.writes, wantWrites) {
+		t.Errorf("wrong bytes on wire: got %#v, want %#v", spiDev.writes, wantWrites)
+	}
+}
+
+func TestReset(t *testing.T) {
+	disp, _, _, resetPin := newTestDisplay()
+
+	if err := disp.Reset(); err != nil {
+		t.Fatalf("Reset() returned error: %v", err)
+	}
+
+	wantValues := []gpio.Value{gpio.High, gpio.Low, gpio.High}
+	if !reflect.DeepEqual(resetPin.values, wantValues) {
+		t.Errorf("wrong reset pin sequence: got %#v, want %#v", resetPin.values, wantValues)
+	}
+}
@@ -0,0 +1,106 @@
+package ssd1306
+
+import (
+	"fmt"
+
+	"github.com/apparentlymart/go-gpio/gpio"
+	"github.com/apparentlymart/go-i2c/i2c"
+	"github.com/apparentlymart/go-spi/spi"
+)
+
+// Transport is the interface through which a Display sends commands and
+// data to the physical controller. It abstracts over the two wiring
+// variants SSD1306 modules are commonly sold with: four-wire SPI (with
+// a separate D/C pin) and I2C (with the mode selected by a control
+// byte prefix on every write).
+//
+// See NewSPITransport and NewI2CTransport for the two implementations
+// provided by this package.
+type Transport interface {
+	// SendCommand sends one or more command bytes, as defined by the
+	// SSD1306 command set.
+	SendCommand(data []byte) error
+
+	// SendData sends raw display RAM data, such as framebuffer
+	// contents being flushed in horizontal, vertical or page
+	// addressing mode.
+	SendData(data []byte) error
+}
+
+type spiTransport struct {
+	spi   spi.WritableDevice
+	dcPin gpio.ValueSetter
+}
+
+// NewSPITransport creates a Transport that talks to the display over
+// SPI, using dcPin to distinguish command writes from data writes as
+// the SSD1306's four-wire SPI mode requires.
+func NewSPITransport(spi spi.WritableDevice, dcPin gpio.ValueSetter) Transport {
+	return &spiTransport{spi, dcPin}
+}
+
+func (t *spiTransport) SendCommand(data []byte) error {
+	t.dcPin.SetValue(gpio.Low)
+	return t.write(data)
+}
+
+func (t *spiTransport) SendData(data []byte) error {
+	t.dcPin.SetValue(gpio.High)
+	return t.write(data)
+}
+
+func (t *spiTransport) write(data []byte) error {
+	n, err := t.spi.Write(data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("Short write")
+	}
+	return nil
+}
+
+// i2cCommandControlByte and i2cDataControlByte are the control bytes
+// the SSD1306 datasheet requires as the first byte of every I2C write,
+// selecting whether the remaining bytes are commands or display data.
+const (
+	i2cCommandControlByte = 0x00
+	i2cDataControlByte    = 0x40
+)
+
+type i2cTransport struct {
+	bus  i2c.Bus
+	addr uint16
+}
+
+// NewI2CTransport creates a Transport that talks to the display over
+// I2C at the given address, as used by the very common 0.96" OLED
+// modules wired for I2C rather than SPI (typically address 0x3C or
+// 0x3D). Each write is prefixed with the control byte the SSD1306
+// datasheet defines for selecting command versus data mode.
+func NewI2CTransport(bus i2c.Bus, addr uint16) Transport {
+	return &i2cTransport{bus, addr}
+}
+
+func (t *i2cTransport) SendCommand(data []byte) error {
+	return t.write(i2cCommandControlByte, data)
+}
+
+func (t *i2cTransport) SendData(data []byte) error {
+	return t.write(i2cDataControlByte, data)
+}
+
+func (t *i2cTransport) write(controlByte byte, data []byte) error {
+	buf := make([]byte, len(data)+1)
+	buf[0] = controlByte
+	copy(buf[1:], data)
+
+	n, err := t.bus.Write(t.addr, buf)
+	if err != nil {
+		return err
+	}
+	if n != len(buf) {
+		return fmt.Errorf("Short write")
+	}
+	return nil
+}
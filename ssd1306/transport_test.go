@@ -0,0 +1,53 @@
+package ssd1306
+
+import (
+	"reflect"
+	"testing"
+)
+
+// mockI2CBus is a mock i2c.Bus that records every write it is given,
+// so tests can assert on the exact bytes (including the leading
+// control byte) an i2cTransport puts on the wire.
+type mockI2CBus struct {
+	writes []i2cWrite
+}
+
+type i2cWrite struct {
+	addr uint16
+	data []byte
+}
+
+func (b *mockI2CBus) Write(addr uint16, data []byte) (int, error) {
+	buf := make([]byte, len(data))
+	copy(buf, data)
+	b.writes = append(b.writes, i2cWrite{addr, buf})
+	return len(data), nil
+}
+
+func TestI2CTransportPrefixesCommandControlByte(t *testing.T) {
+	bus := &mockI2CBus{}
+	transport := NewI2CTransport(bus, 0x3C)
+
+	if err := transport.SendCommand([]byte{0xAE}); err != nil {
+		t.Fatalf("SendCommand() returned error: %v", err)
+	}
+
+	wantWrites := []i2cWrite{{0x3C, []byte{0x00, 0xAE}}}
+	if !reflect.DeepEqual(bus.writes, wantWrites) {
+		t.Errorf("wrong bytes on wire: got %#v, want %#v", bus.writes, wantWrites)
+	}
+}
+
+func TestI2CTransportPrefixesDataControlByte(t *testing.T) {
+	bus := &mockI2CBus{}
+	transport := NewI2CTransport(bus, 0x3D)
+
+	if err := transport.SendData([]byte{0x01, 0x02, 0x03}); err != nil {
+		t.Fatalf("SendData() returned error: %v", err)
+	}
+
+	wantWrites := []i2cWrite{{0x3D, []byte{0x40, 0x01, 0x02, 0x03}}}
+	if !reflect.DeepEqual(bus.writes, wantWrites) {
+		t.Errorf("wrong bytes on wire: got %#v, want %#v", bus.writes, wantWrites)
+	}
+}
@@ -0,0 +1,57 @@
+package text
+
+import "github.com/apparentlymart/go-ssd1306/ssd1306"
+
+// Console is a simple monospace terminal built on top of a
+// Framebuffer: it draws lines of text at an advancing cursor position,
+// scrolling earlier lines off the top of the Framebuffer once the
+// bottom is reached.
+type Console struct {
+	fb   *ssd1306.Framebuffer
+	font *Font
+	y    int
+}
+
+// NewConsole creates a Console that draws into fb using font, starting
+// at the top of the Framebuffer.
+func NewConsole(fb *ssd1306.Framebuffer, font *Font) *Console {
+	return &Console{fb: fb, font: font}
+}
+
+// Println draws s as a new line, wrapping it the same way DrawString
+// does. A single call to Println may therefore occupy more than one
+// visual line if s is wider than the Framebuffer. If there isn't room
+// for all of those lines below the current cursor position, the
+// Framebuffer's contents are first scrolled up to make room.
+func (c *Console) Println(s string) {
+	bounds := c.fb.Bounds()
+	lineHeight := c.font.lineHeight()
+	lines := wrappedLineCount(bounds.Max.X, c.font, s)
+	height := lines * lineHeight
+
+	if c.y+height > bounds.Max.Y {
+		scrollBy := c.y + height - bounds.Max.Y
+		c.scrollUp(scrollBy)
+		c.y -= scrollBy
+		if c.y < bounds.Min.Y {
+			c.y = bounds.Min.Y
+		}
+	}
+
+	DrawString(c.fb, 0, c.y, c.font, s)
+	c.y += height
+}
+
+func (c *Console) scrollUp(rows int) {
+	bounds := c.fb.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		srcY := y + rows
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			var on bool
+			if srcY < bounds.Max.Y {
+				on = c.fb.Pixel(x, srcY)
+			}
+			c.fb.SetPixel(x, y, on)
+		}
+	}
+}
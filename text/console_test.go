@@ -0,0 +1,72 @@
+package text
+
+import (
+	"testing"
+
+	"github.com/apparentlymart/go-ssd1306/ssd1306"
+)
+
+// assertFramebuffersEqual fails the test with the first differing pixel,
+// if any, between got and want.
+func assertFramebuffersEqual(t *testing.T, got, want *ssd1306.Framebuffer) {
+	t.Helper()
+	bounds := want.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			if got.Pixel(x, y) != want.Pixel(x, y) {
+				t.Fatalf("pixel (%d, %d): got %v, want %v", x, y, got.Pixel(x, y), want.Pixel(x, y))
+			}
+		}
+	}
+}
+
+// TestPrintlnAdvancesPastWrappedLines reproduces the bug where Println
+// advanced its cursor by exactly one line-height regardless of how
+// many visual lines DrawString actually wrapped a string onto: with a
+// 30px-wide Framebuffer and Font5x7 (5 columns/glyph, so lines wrap
+// after 5 characters), a 10-character string wraps onto two lines, so
+// a following Println must start below both of them, not just one.
+func TestPrintlnAdvancesPastWrappedLines(t *testing.T) {
+	fb := ssd1306.NewFramebuffer(30, 64)
+	c := NewConsole(fb, Font5x7)
+
+	c.Println("ABCDEFGHIJ") // wraps to two lines, at y=0 and y=8
+	c.Println("SECOND")     // must start at y=16, not y=8
+
+	want := ssd1306.NewFramebuffer(30, 64)
+	DrawString(want, 0, 0, Font5x7, "ABCDEFGHIJ")
+	DrawString(want, 0, 16, Font5x7, "SECOND")
+
+	assertFramebuffersEqual(t, fb, want)
+}
+
+// TestPrintlnScrollsPastWrappedLinesAtBottom reproduces the same bug
+// as TestPrintlnAdvancesPastWrappedLines, but with a Framebuffer only
+// tall enough for the two wrapped lines the first Println produces, so
+// that the following Println must scroll up by one line-height to make
+// room, rather than drawing over the second wrapped line.
+func TestPrintlnScrollsPastWrappedLinesAtBottom(t *testing.T) {
+	fb := ssd1306.NewFramebuffer(30, 16)
+	c := NewConsole(fb, Font5x7)
+
+	c.Println("ABCDEFGHIJ") // fills both of the framebuffer's two lines
+	c.Println("Z")          // must scroll up by one line-height first
+
+	beforeScroll := ssd1306.NewFramebuffer(30, 16)
+	DrawString(beforeScroll, 0, 0, Font5x7, "ABCDEFGHIJ")
+
+	want := ssd1306.NewFramebuffer(30, 16)
+	for y := 0; y < 16; y++ {
+		for x := 0; x < 30; x++ {
+			srcY := y + 8
+			var on bool
+			if srcY < 16 {
+				on = beforeScroll.Pixel(x, srcY)
+			}
+			want.SetPixel(x, y, on)
+		}
+	}
+	DrawString(want, 0, 8, Font5x7, "Z")
+
+	assertFramebuffersEqual(t, fb, want)
+}
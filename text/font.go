@@ -0,0 +1,134 @@
+// Package text renders strings into an ssd1306.Framebuffer using
+// simple bitmap fonts.
+package text
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/apparentlymart/go-ssd1306/ssd1306"
+)
+
+// Font is a fixed-width bitmap font. Glyphs are stored column-major
+// and page-aligned, matching the SSD1306's own page/column memory
+// layout: for a font whose Height is a multiple of 8, each glyph's
+// byte slice holds one page's worth of columns (Width bytes) followed
+// by the next page's, in the same order a Framebuffer stores its own
+// pixel data.
+type Font struct {
+	Width, Height int
+	Glyphs        map[rune][]byte
+}
+
+// pages returns the number of 8-row pages the font's glyphs are split
+// into.
+func (f *Font) pages() int {
+	return (f.Height + 7) / 8
+}
+
+// glyph returns the bitmap for r, falling back to '?' if the font has
+// no glyph for r, and returning false if it has neither.
+func (f *Font) glyph(r rune) ([]byte, bool) {
+	if g, ok := f.Glyphs[r]; ok {
+		return g, true
+	}
+	g, ok := f.Glyphs['?']
+	return g, ok
+}
+
+// advance is the horizontal distance, in pixels, from the start of one
+// glyph to the start of the next.
+func (f *Font) advance() int {
+	return f.Width + 1
+}
+
+// lineHeight is the vertical distance, in pixels, between the start of
+// one line of text and the start of the next.
+func (f *Font) lineHeight() int {
+	return f.Height + 1
+}
+
+// DrawString draws s into fb using font, with its top-left corner at
+// (x, y). Explicit newlines in s start a new line at x, font.Height+1
+// pixels below the previous one; text also wraps onto a new line
+// automatically when the next glyph would extend past the right edge
+// of fb's bounds.
+func DrawString(fb *ssd1306.Framebuffer, x, y int, font *Font, s string) {
+	maxX := fb.Bounds().Max.X
+	walkWrappedLines(maxX-x, font, s, func(r rune, line, col int) {
+		drawGlyph(fb, x+col, y+line*font.lineHeight(), font, r)
+	})
+}
+
+// walkWrappedLines replays the same line-wrapping decisions DrawString
+// uses to place glyphs, so that other code which needs to know how
+// many lines a string will occupy (such as Console.Println) can't
+// drift out of sync with DrawString's actual behavior.
+//
+// For each non-newline rune it calls onGlyph with the rune, its
+// zero-based line number, and its column offset (in pixels) from the
+// start of that line. It returns the total number of lines s occupies.
+func walkWrappedLines(width int, font *Font, s string, onGlyph func(r rune, line, col int)) int {
+	line, cursorX := 0, 0
+	for _, r := range s {
+		if r == '\n' {
+			line++
+			cursorX = 0
+			continue
+		}
+		if cursorX+font.Width > width {
+			line++
+			cursorX = 0
+		}
+		onGlyph(r, line, cursorX)
+		cursorX += font.advance()
+	}
+	return line + 1
+}
+
+// wrappedLineCount returns how many lines s occupies when drawn with
+// font into a Framebuffer width pixels wide, accounting for the same
+// wrapping DrawString performs.
+func wrappedLineCount(width int, font *Font, s string) int {
+	return walkWrappedLines(width, font, s, func(rune, int, int) {})
+}
+
+func drawGlyph(fb *ssd1306.Framebuffer, x, y int, font *Font, r rune) {
+	glyph, ok := font.glyph(r)
+	if !ok {
+		return
+	}
+	pages := font.pages()
+	for page := 0; page < pages; page++ {
+		rowBase := y + page*8
+		for col := 0; col < font.Width; col++ {
+			b := glyph[page*font.Width+col]
+			for bit := 0; bit < 8; bit++ {
+				py := rowBase + bit
+				if py >= y+font.Height {
+					break
+				}
+				fb.SetPixel(x+col, py, b&(1<<uint(bit)) != 0)
+			}
+		}
+	}
+}
+
+// MeasureString returns the width and height, in pixels, that s would
+// occupy if drawn with font via DrawString, ignoring any wrapping that
+// DrawString might additionally apply at the target Framebuffer's
+// edge.
+func MeasureString(font *Font, s string) (width, height int) {
+	lines := strings.Split(s, "\n")
+	longest := 0
+	for _, line := range lines {
+		if n := utf8.RuneCountInString(line); n > longest {
+			longest = n
+		}
+	}
+	if longest > 0 {
+		width = longest*font.advance() - 1
+	}
+	height = len(lines)*font.lineHeight() - 1
+	return width, height
+}
@@ -0,0 +1,17 @@
+package text
+
+// Font5x7 is a bundled 5x7 ASCII bitmap font, suitable for small
+// single-line status text on a Framebuffer.
+var Font5x7 = buildFont5x7()
+
+func buildFont5x7() *Font {
+	glyphs := make(map[rune][]byte, len(asciiGlyphs))
+	for _, p := range asciiGlyphs {
+		data := make([]byte, 5)
+		for col := 0; col < 5; col++ {
+			data[col] = p.columnBits(col)
+		}
+		glyphs[p.r] = data
+	}
+	return &Font{Width: 5, Height: 7, Glyphs: glyphs}
+}
@@ -0,0 +1,36 @@
+package text
+
+// Font8x16 is a bundled 8x16 ASCII bitmap font, scaled up from the
+// same glyph source as Font5x7 for use where a larger, two-page-tall
+// font is wanted.
+var Font8x16 = buildFont8x16()
+
+func buildFont8x16() *Font {
+	const width, height = 8, 16
+
+	glyphs := make(map[rune][]byte, len(asciiGlyphs))
+	for _, p := range asciiGlyphs {
+		// Two pages of `width` columns each, in the same page-major
+		// order the Framebuffer itself uses.
+		data := make([]byte, width*2)
+		for col := 0; col < width; col++ {
+			srcCol := col * 5 / width
+
+			var lo, hi byte
+			for row := 0; row < 8; row++ {
+				if p.rows[row*7/height][srcCol] == '#' {
+					lo |= 1 << uint(row)
+				}
+			}
+			for row := 0; row < 8; row++ {
+				if p.rows[(row+8)*7/height][srcCol] == '#' {
+					hi |= 1 << uint(row)
+				}
+			}
+			data[col] = lo
+			data[width+col] = hi
+		}
+		glyphs[p.r] = data
+	}
+	return &Font{Width: width, Height: height, Glyphs: glyphs}
+}
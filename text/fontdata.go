@@ -0,0 +1,476 @@
+package text
+
+// glyphPattern is the source representation a bundled font is defined
+// in: seven rows of five characters each, '#' for an on pixel and '.'
+// for off. buildFont5x7 and buildFont8x16 convert these into the
+// packed Glyphs format Font expects.
+type glyphPattern struct {
+	r    rune
+	rows [7]string
+}
+
+// asciiGlyphs covers the letters, digits and punctuation needed for
+// ordinary status and log output. Runes without an entry fall back to
+// '?' when drawn; lowercase letters intentionally reuse their
+// uppercase glyph, since a 5-pixel-wide cell has no room for
+// descenders.
+var asciiGlyphs = withLowercase([]glyphPattern{
+	{' ', [7]string{
+		".....",
+		".....",
+		".....",
+		".....",
+		".....",
+		".....",
+		".....",
+	}},
+	{'0', [7]string{
+		".###.",
+		"#...#",
+		"#..##",
+		"#.#.#",
+		"##..#",
+		"#...#",
+		".###.",
+	}},
+	{'1', [7]string{
+		"..#..",
+		".##..",
+		"..#..",
+		"..#..",
+		"..#..",
+		"..#..",
+		".###.",
+	}},
+	{'2', [7]string{
+		".###.",
+		"#...#",
+		"....#",
+		"...#.",
+		"..#..",
+		".#...",
+		"#####",
+	}},
+	{'3', [7]string{
+		".###.",
+		"#...#",
+		"....#",
+		"..##.",
+		"....#",
+		"#...#",
+		".###.",
+	}},
+	{'4', [7]string{
+		"...#.",
+		"..##.",
+		".#.#.",
+		"#..#.",
+		"#####",
+		"...#.",
+		"...#.",
+	}},
+	{'5', [7]string{
+		"#####",
+		"#....",
+		"####.",
+		"....#",
+		"....#",
+		"#...#",
+		".###.",
+	}},
+	{'6', [7]string{
+		"..##.",
+		".#...",
+		"#....",
+		"####.",
+		"#...#",
+		"#...#",
+		".###.",
+	}},
+	{'7', [7]string{
+		"#####",
+		"....#",
+		"...#.",
+		"..#..",
+		".#...",
+		".#...",
+		".#...",
+	}},
+	{'8', [7]string{
+		".###.",
+		"#...#",
+		"#...#",
+		".###.",
+		"#...#",
+		"#...#",
+		".###.",
+	}},
+	{'9', [7]string{
+		".###.",
+		"#...#",
+		"#...#",
+		".####",
+		"....#",
+		"...#.",
+		".##..",
+	}},
+	{'A', [7]string{
+		"..#..",
+		".#.#.",
+		"#...#",
+		"#...#",
+		"#####",
+		"#...#",
+		"#...#",
+	}},
+	{'B', [7]string{
+		"####.",
+		"#...#",
+		"#...#",
+		"####.",
+		"#...#",
+		"#...#",
+		"####.",
+	}},
+	{'C', [7]string{
+		".###.",
+		"#...#",
+		"#....",
+		"#....",
+		"#....",
+		"#...#",
+		".###.",
+	}},
+	{'D', [7]string{
+		"###..",
+		"#..#.",
+		"#...#",
+		"#...#",
+		"#...#",
+		"#..#.",
+		"###..",
+	}},
+	{'E', [7]string{
+		"#####",
+		"#....",
+		"#....",
+		"####.",
+		"#....",
+		"#....",
+		"#####",
+	}},
+	{'F', [7]string{
+		"#####",
+		"#....",
+		"#....",
+		"####.",
+		"#....",
+		"#....",
+		"#....",
+	}},
+	{'G', [7]string{
+		".###.",
+		"#...#",
+		"#....",
+		"#.###",
+		"#...#",
+		"#...#",
+		".###.",
+	}},
+	{'H', [7]string{
+		"#...#",
+		"#...#",
+		"#...#",
+		"#####",
+		"#...#",
+		"#...#",
+		"#...#",
+	}},
+	{'I', [7]string{
+		".###.",
+		"..#..",
+		"..#..",
+		"..#..",
+		"..#..",
+		"..#..",
+		".###.",
+	}},
+	{'J', [7]string{
+		"..###",
+		"...#.",
+		"...#.",
+		"...#.",
+		"...#.",
+		"#..#.",
+		".##..",
+	}},
+	{'K', [7]string{
+		"#...#",
+		"#..#.",
+		"#.#..",
+		"##...",
+		"#.#..",
+		"#..#.",
+		"#...#",
+	}},
+	{'L', [7]string{
+		"#....",
+		"#....",
+		"#....",
+		"#....",
+		"#....",
+		"#....",
+		"#####",
+	}},
+	{'M', [7]string{
+		"#...#",
+		"##.##",
+		"#.#.#",
+		"#...#",
+		"#...#",
+		"#...#",
+		"#...#",
+	}},
+	{'N', [7]string{
+		"#...#",
+		"##..#",
+		"#.#.#",
+		"#..##",
+		"#...#",
+		"#...#",
+		"#...#",
+	}},
+	{'O', [7]string{
+		".###.",
+		"#...#",
+		"#...#",
+		"#...#",
+		"#...#",
+		"#...#",
+		".###.",
+	}},
+	{'P', [7]string{
+		"####.",
+		"#...#",
+		"#...#",
+		"####.",
+		"#....",
+		"#....",
+		"#....",
+	}},
+	{'Q', [7]string{
+		".###.",
+		"#...#",
+		"#...#",
+		"#...#",
+		"#.#.#",
+		"#..#.",
+		".##.#",
+	}},
+	{'R', [7]string{
+		"####.",
+		"#...#",
+		"#...#",
+		"####.",
+		"#.#..",
+		"#..#.",
+		"#...#",
+	}},
+	{'S', [7]string{
+		".####",
+		"#....",
+		"#....",
+		".###.",
+		"....#",
+		"....#",
+		"####.",
+	}},
+	{'T', [7]string{
+		"#####",
+		"..#..",
+		"..#..",
+		"..#..",
+		"..#..",
+		"..#..",
+		"..#..",
+	}},
+	{'U', [7]string{
+		"#...#",
+		"#...#",
+		"#...#",
+		"#...#",
+		"#...#",
+		"#...#",
+		".###.",
+	}},
+	{'V', [7]string{
+		"#...#",
+		"#...#",
+		"#...#",
+		"#...#",
+		"#...#",
+		".#.#.",
+		"..#..",
+	}},
+	{'W', [7]string{
+		"#...#",
+		"#...#",
+		"#...#",
+		"#.#.#",
+		"#.#.#",
+		"##.##",
+		"#...#",
+	}},
+	{'X', [7]string{
+		"#...#",
+		".#.#.",
+		"..#..",
+		"..#..",
+		"..#..",
+		".#.#.",
+		"#...#",
+	}},
+	{'Y', [7]string{
+		"#...#",
+		".#.#.",
+		"..#..",
+		"..#..",
+		"..#..",
+		"..#..",
+		"..#..",
+	}},
+	{'Z', [7]string{
+		"#####",
+		"....#",
+		"...#.",
+		"..#..",
+		".#...",
+		"#....",
+		"#####",
+	}},
+	{'.', [7]string{
+		".....",
+		".....",
+		".....",
+		".....",
+		".....",
+		"..##.",
+		"..##.",
+	}},
+	{',', [7]string{
+		".....",
+		".....",
+		".....",
+		".....",
+		"..##.",
+		"..##.",
+		".#...",
+	}},
+	{':', [7]string{
+		".....",
+		"..##.",
+		"..##.",
+		".....",
+		"..##.",
+		"..##.",
+		".....",
+	}},
+	{';', [7]string{
+		".....",
+		"..##.",
+		"..##.",
+		".....",
+		"..##.",
+		"..##.",
+		".#...",
+	}},
+	{'!', [7]string{
+		"..#..",
+		"..#..",
+		"..#..",
+		"..#..",
+		"..#..",
+		".....",
+		"..#..",
+	}},
+	{'?', [7]string{
+		".###.",
+		"#...#",
+		"....#",
+		"...#.",
+		"..#..",
+		".....",
+		"..#..",
+	}},
+	{'\'', [7]string{
+		"..#..",
+		"..#..",
+		".....",
+		".....",
+		".....",
+		".....",
+		".....",
+	}},
+	{'-', [7]string{
+		".....",
+		".....",
+		".....",
+		"#####",
+		".....",
+		".....",
+		".....",
+	}},
+	{'(', [7]string{
+		"...#.",
+		"..#..",
+		".#...",
+		".#...",
+		".#...",
+		"..#..",
+		"...#.",
+	}},
+	{')', [7]string{
+		".#...",
+		"..#..",
+		"...#.",
+		"...#.",
+		"...#.",
+		"..#..",
+		".#...",
+	}},
+	{'/', [7]string{
+		"....#",
+		"...#.",
+		"...#.",
+		"..#..",
+		".#...",
+		".#...",
+		"#....",
+	}},
+})
+
+// withLowercase returns glyphs extended with a lowercase copy of each
+// uppercase letter. It is applied as part of asciiGlyphs' own
+// initialization, so the bundled fonts built from asciiGlyphs at
+// package initialization include the lowercase entries.
+func withLowercase(glyphs []glyphPattern) []glyphPattern {
+	lower := make([]glyphPattern, 0, 26)
+	for _, g := range glyphs {
+		if g.r >= 'A' && g.r <= 'Z' {
+			lower = append(lower, glyphPattern{g.r + ('a' - 'A'), g.rows})
+		}
+	}
+	return append(glyphs, lower...)
+}
+
+// columnBits returns the 8-bit column value for column col of pattern,
+// with the top row in bit 0, matching the Framebuffer page layout.
+func (p glyphPattern) columnBits(col int) byte {
+	var b byte
+	for row := 0; row < 7; row++ {
+		if p.rows[row][col] == '#' {
+			b |= 1 << uint(row)
+		}
+	}
+	return b
+}